@@ -0,0 +1,69 @@
+package model
+
+import "strings"
+
+// ArrayDriver selects which Go array type PostgreSQL array columns
+// are generated as.
+type ArrayDriver int
+
+const (
+	// ArrayDriverPQ generates lib/pq's pq.XxxArray types (the default).
+	ArrayDriverPQ ArrayDriver = iota
+	// ArrayDriverPGX generates pgx's pgtype.FlatArray-based types.
+	ArrayDriverPGX
+)
+
+// activeArrayDriver is the ArrayDriver consulted when generating
+// PostgreSQL array columns. It corresponds to gen.Config's
+// ArrayDriver option.
+var activeArrayDriver = ArrayDriverPQ
+
+// SetArrayDriver configures the ArrayDriver used for subsequently
+// generated PostgreSQL array columns.
+func SetArrayDriver(driver ArrayDriver) {
+	activeArrayDriver = driver
+}
+
+// arrayGoType returns the Go type generated for a PostgreSQL array
+// column holding elements of elemKind ("int", "text", "bool" or
+// "float"), honoring the configured ArrayDriver.
+func arrayGoType(elemKind string) string {
+	if activeArrayDriver == ArrayDriverPGX {
+		switch elemKind {
+		case "int":
+			return "pgtype.FlatArray[int64]"
+		case "bool":
+			return "pgtype.FlatArray[bool]"
+		case "float":
+			return "pgtype.FlatArray[float64]"
+		default:
+			return "pgtype.FlatArray[string]"
+		}
+	}
+	switch elemKind {
+	case "int":
+		return "pq.Int64Array"
+	case "bool":
+		return "pq.BoolArray"
+	case "float":
+		return "pq.Float64Array"
+	default:
+		return "pq.StringArray"
+	}
+}
+
+// rangeGoType returns the generated types.Range[T] instantiation for
+// a PostgreSQL range column holding bounds of elemGoType.
+func rangeGoType(elemGoType string) string {
+	return "types.Range[" + elemGoType + "]"
+}
+
+// isArrayGoType reports whether typ is a lib/pq array type (any
+// "pq.*Array") or a pgtype.FlatArray instantiation, which covers every
+// type arrayGoType can produce regardless of the configured
+// ArrayDriver, so GenType can route it to the field.Array query
+// builder kind.
+func isArrayGoType(typ string) bool {
+	return strings.HasPrefix(typ, "pq.") && strings.HasSuffix(typ, "Array") ||
+		strings.HasPrefix(typ, "pgtype.FlatArray[")
+}
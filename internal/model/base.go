@@ -133,7 +133,7 @@ var (
 		"mediumblob": func(string) string { return "[]byte" },
 		"longblob":   func(string) string { return "[]byte" },
 		"text":       func(string) string { return "string" },
-		"json":       func(string) string { return "string" },
+		"json":       func(string) string { return activeJSONFieldType.GoType() },
 		"enum":       func(string) string { return "string" },
 		"time":       func(string) string { return "time.Time" },
 		"date":       func(string) string { return "time.Time" },
@@ -222,7 +222,13 @@ func (m *Field) GenType() string {
 	if m.CustomGenType != "" {
 		return m.CustomGenType
 	}
+	if m.hasSerializerTag() {
+		return "Serializer"
+	}
 	typ := strings.TrimLeft(m.Type, "*")
+	if isArrayGoType(typ) {
+		return "Array"
+	}
 	switch typ {
 	case "string", "bytes":
 		return strings.Title(typ)
@@ -0,0 +1,66 @@
+package model
+
+import "strings"
+
+// Dialect identifies the target SQL database family used to select
+// data type mappings and reserved-word handling during generation.
+type Dialect string
+
+const (
+	// MySQL ...
+	MySQL Dialect = "mysql"
+	// Postgres ...
+	Postgres Dialect = "postgres"
+	// SQLite ...
+	SQLite Dialect = "sqlite"
+	// SQLServer ...
+	SQLServer Dialect = "sqlserver"
+	// Oracle ...
+	Oracle Dialect = "oracle"
+)
+
+// dialectDataTypes holds per-dialect data type overrides layered on
+// top of the shared dataType mapping. A dialect only needs to
+// register the types that diverge from the default, MySQL-flavoured
+// mapping; anything it doesn't register falls through to dataType.
+var dialectDataTypes = map[Dialect]dataTypeMap{
+	Postgres:  postgresDataType,
+	SQLite:    sqliteDataType,
+	SQLServer: sqlServerDataType,
+	Oracle:    oracleDataType,
+}
+
+// SetDataTypeFor registers a mapping function for a specific database
+// type scoped to dialect, leaving the other dialects and the shared
+// default set untouched.
+//
+// Parameters:
+//   - dialect: the target SQL dialect.
+//   - dbType: the name of the database type to map.
+//   - getTypeFunc: a function that returns the application's data type for the given dbType.
+func SetDataTypeFor(dialect Dialect, dbType string, getTypeFunc dataTypeMapping) {
+	m, ok := dialectDataTypes[dialect]
+	if !ok {
+		m = dataTypeMap{}
+		dialectDataTypes[dialect] = m
+	}
+	m[strings.ToLower(dbType)] = getTypeFunc
+}
+
+// GetDataTypeFor returns the corresponding Go data type for a given
+// SQL data type string under dialect. It first consults dialect's own
+// overrides, then falls back to the shared default mapping used by
+// GetDataType (and ultimately to defaultDataType).
+//
+// Parameters:
+//   - dialect: the target SQL dialect.
+//   - sqlDataType: the SQL data type as a string.
+//   - detailType: the full column type string (e.g. "tinyint(1)"), used by mappings that need more than the bare type name.
+func GetDataTypeFor(dialect Dialect, sqlDataType, detailType string) string {
+	if m, ok := dialectDataTypes[dialect]; ok {
+		if convert, ok := m[strings.ToLower(sqlDataType)]; ok {
+			return convert(detailType)
+		}
+	}
+	return dataType.Get(sqlDataType, detailType)
+}
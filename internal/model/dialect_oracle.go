@@ -0,0 +1,15 @@
+package model
+
+// oracleDataType holds Oracle-specific overrides layered on top of
+// the shared dataType mapping via dialectDataTypes[Oracle].
+var oracleDataType = dataTypeMap{
+	"number":    func(string) string { return "float64" },
+	"varchar2":  func(string) string { return "string" },
+	"nvarchar2": func(string) string { return "string" },
+	"clob":      func(string) string { return "string" },
+	"nclob":     func(string) string { return "string" },
+	"blob":      func(string) string { return "[]byte" },
+	"raw":       func(string) string { return "[]byte" },
+	"date":      func(string) string { return "time.Time" },
+	"timestamp": func(string) string { return "time.Time" },
+}
@@ -0,0 +1,51 @@
+package model
+
+// postgresDataType holds PostgreSQL-specific overrides layered on top
+// of the shared dataType mapping via dialectDataTypes[Postgres].
+var postgresDataType = dataTypeMap{
+	"serial":      func(string) string { return "int32" },
+	"smallserial": func(string) string { return "int16" },
+	"bigserial":   func(string) string { return "int64" },
+	"uuid":        func(string) string { return "string" },
+	"jsonb":       func(string) string { return activeJSONFieldType.GoType() },
+	"json":        func(string) string { return activeJSONFieldType.GoType() },
+	"timestamp":   func(string) string { return timeGoType(false) },
+	"timestamptz": func(string) string { return timeGoType(true) },
+	"interval":    func(string) string { return "time.Duration" },
+	"inet":        func(string) string { return "string" },
+	"cidr":        func(string) string { return "string" },
+	"numeric":     func(string) string { return "float64" },
+	"bytea":       func(string) string { return "[]byte" },
+	"boolean":     func(string) string { return "bool" },
+	"bool":        func(string) string { return "bool" },
+
+	// Arrays. Both the bracket SQL-standard spelling and the
+	// "_"-prefixed oid name Postgres drivers report via udt_name are
+	// registered for every element kind, since migrators typically
+	// only produce the latter.
+	"integer[]": func(string) string { return arrayGoType("int") },
+	"int4[]":    func(string) string { return arrayGoType("int") },
+	"_int4":     func(string) string { return arrayGoType("int") },
+	"bigint[]":  func(string) string { return arrayGoType("int") },
+	"int8[]":    func(string) string { return arrayGoType("int") },
+	"_int8":     func(string) string { return arrayGoType("int") },
+	"text[]":    func(string) string { return arrayGoType("text") },
+	"_text":     func(string) string { return arrayGoType("text") },
+	"varchar[]": func(string) string { return arrayGoType("text") },
+	"_varchar":  func(string) string { return arrayGoType("text") },
+	"uuid[]":    func(string) string { return arrayGoType("text") },
+	"_uuid":     func(string) string { return arrayGoType("text") },
+	"boolean[]": func(string) string { return arrayGoType("bool") },
+	"_bool":     func(string) string { return arrayGoType("bool") },
+	"numeric[]": func(string) string { return arrayGoType("float") },
+	"_numeric":  func(string) string { return arrayGoType("float") },
+	"_float8":   func(string) string { return arrayGoType("float") },
+
+	// Ranges.
+	"int4range": func(string) string { return rangeGoType("int32") },
+	"int8range": func(string) string { return rangeGoType("int64") },
+	"numrange":  func(string) string { return rangeGoType("float64") },
+	"daterange": func(string) string { return rangeGoType("time.Time") },
+	"tsrange":   func(string) string { return rangeGoType("time.Time") },
+	"tstzrange": func(string) string { return rangeGoType("time.Time") },
+}
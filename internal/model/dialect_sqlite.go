@@ -0,0 +1,18 @@
+package model
+
+// sqliteDataType holds SQLite-specific overrides layered on top of
+// the shared dataType mapping via dialectDataTypes[SQLite]. Of the
+// entries below, integer, int and numeric map differently from the
+// shared mapping; the others map to the same Go types as the shared
+// mapping does.
+var sqliteDataType = dataTypeMap{
+	"integer":  func(string) string { return "int64" },
+	"int":      func(string) string { return "int64" },
+	"real":     func(string) string { return "float64" },
+	"text":     func(string) string { return "string" },
+	"blob":     func(string) string { return "[]byte" },
+	"numeric":  func(string) string { return "float64" },
+	"boolean":  func(string) string { return "bool" },
+	"datetime": func(string) string { return "time.Time" },
+	"date":     func(string) string { return "time.Time" },
+}
@@ -0,0 +1,19 @@
+package model
+
+// sqlServerDataType holds SQL Server-specific overrides layered on
+// top of the shared dataType mapping via dialectDataTypes[SQLServer].
+var sqlServerDataType = dataTypeMap{
+	"nvarchar":         func(string) string { return "string" },
+	"varchar":          func(string) string { return "string" },
+	"nchar":            func(string) string { return "string" },
+	"ntext":            func(string) string { return "string" },
+	"datetime2":        func(string) string { return timeGoType(false) },
+	"datetimeoffset":   func(string) string { return timeGoType(true) },
+	"smalldatetime":    func(string) string { return timeGoType(false) },
+	"uniqueidentifier": func(string) string { return "string" },
+	"money":            func(string) string { return "float64" },
+	"smallmoney":       func(string) string { return "float64" },
+	"bit":              func(string) string { return "bool" },
+	"varbinary":        func(string) string { return "[]byte" },
+	"image":            func(string) string { return "[]byte" },
+}
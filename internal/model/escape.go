@@ -0,0 +1,43 @@
+package model
+
+import "strings"
+
+// reservedWordsFor returns the reserved-word set to check ColumnName
+// against for dialect, falling back to an empty set for dialects
+// without a dedicated list.
+func reservedWordsFor(dialect Dialect) KeyWord {
+	switch dialect {
+	case MySQL:
+		return MySQLKeywords
+	case Postgres:
+		return PostgresKeywords
+	case SQLServer:
+		return SQLServerKeywords
+	case Oracle:
+		return OracleKeywords
+	default:
+		return KeyWord{}
+	}
+}
+
+// quoteIdentifier wraps name in dialect's identifier quoting.
+func quoteIdentifier(dialect Dialect, name string) string {
+	switch dialect {
+	case Postgres, SQLite, Oracle:
+		return `"` + name + `"`
+	case SQLServer:
+		return "[" + name + "]"
+	default: // MySQL
+		return "`" + name + "`"
+	}
+}
+
+// EscapeColumnFor quotes ColumnName in the GORMTag's column tag using
+// dialect's identifier quoting whenever the bare name collides with
+// one of dialect's reserved words, e.g. "user", "order" or "group".
+func (m *Field) EscapeColumnFor(dialect Dialect) *Field {
+	if reservedWordsFor(dialect).FullMatch(strings.ToLower(m.ColumnName)) {
+		m.GORMTag.Set("column", quoteIdentifier(dialect, m.ColumnName))
+	}
+	return m
+}
@@ -0,0 +1,91 @@
+package model
+
+import "strings"
+
+// JSONFieldType selects the Go representation generated for JSON /
+// JSONB columns. It corresponds to gen.Config's WithJSONFieldType
+// option.
+type JSONFieldType int
+
+const (
+	// JSONRawMessage generates json.RawMessage (the default).
+	JSONRawMessage JSONFieldType = iota
+	// JSONMap generates map[string]any.
+	JSONMap
+	// JSONDatatypes generates datatypes.JSON (gorm.io/datatypes).
+	JSONDatatypes
+)
+
+// GoType returns the Go type name used in the generated struct field
+// for t.
+func (t JSONFieldType) GoType() string {
+	switch t {
+	case JSONMap:
+		return "map[string]any"
+	case JSONDatatypes:
+		return "datatypes.JSON"
+	default:
+		return "json.RawMessage"
+	}
+}
+
+// activeJSONFieldType is the JSONFieldType consulted by the json/jsonb
+// dataType mapping functions and by ApplyJSONFieldType. It corresponds
+// to gen.Config's WithJSONFieldType option.
+var activeJSONFieldType = JSONRawMessage
+
+// SetJSONFieldType configures the JSONFieldType used for JSON/JSONB
+// columns that don't have a FieldJSONType override.
+func SetJSONFieldType(t JSONFieldType) {
+	activeJSONFieldType = t
+}
+
+// jsonFieldTypes holds per-column JSON type overrides registered via
+// FieldJSONType, keyed by "tableName.columnName". They take
+// precedence over the JSONFieldType configured globally.
+var jsonFieldTypes = map[string]string{}
+
+// FieldJSONType overrides the generated Go type for a single JSON /
+// JSONB column, e.g. typing a "metadata" column as "*UserMetadata"
+// while other JSON columns keep the globally configured JSONFieldType.
+func FieldJSONType(tableName, columnName, goType string) {
+	jsonFieldTypes[tableName+"."+columnName] = goType
+}
+
+// JSONTypeFor returns the Go type registered for tableName.columnName
+// via FieldJSONType. ok is false when no override exists and the
+// caller should fall back to the globally configured JSONFieldType.
+func JSONTypeFor(tableName, columnName string) (goType string, ok bool) {
+	goType, ok = jsonFieldTypes[tableName+"."+columnName]
+	return
+}
+
+// ApplyJSONType sets the field's generated Go type to goType and
+// marks it to use GORM's JSON serializer, so GenType reports
+// "Serializer" and Tags renders a "serializer:json" gorm tag
+// alongside "column".
+func (m *Field) ApplyJSONType(goType string) *Field {
+	m.Type = goType
+	m.GORMTag.Set("serializer", "json")
+	return m
+}
+
+// ApplyJSONFieldType resolves the Go type for a JSON/JSONB column of
+// tableName (using m.ColumnName), preferring a FieldJSONType override
+// and falling back to the globally configured JSONFieldType, then
+// applies it via ApplyJSONType. The generator should call this for
+// every column whose SQL type is json/jsonb instead of using the
+// dataType mapping's result directly.
+func (m *Field) ApplyJSONFieldType(tableName string) *Field {
+	goType, ok := JSONTypeFor(tableName, m.ColumnName)
+	if !ok {
+		goType = activeJSONFieldType.GoType()
+	}
+	return m.ApplyJSONType(goType)
+}
+
+// hasSerializerTag reports whether GORMTag already carries a
+// "serializer:" tag, e.g. set by ApplyJSONType.
+func (m *Field) hasSerializerTag() bool {
+	return strings.Contains(m.GORMTag.Build(), "serializer:")
+}
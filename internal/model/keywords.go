@@ -0,0 +1,82 @@
+package model
+
+// MySQLKeywords ...
+var MySQLKeywords = KeyWord{
+	words: []string{
+		"add", "all", "alter", "and", "as", "asc", "between", "by", "case", "check",
+		"column", "condition", "constraint", "create", "cross", "current_date", "current_time",
+		"current_timestamp", "database", "default", "delete", "desc", "distinct", "drop", "else",
+		"exists", "explain", "false", "for", "foreign", "from", "group", "having", "if", "in",
+		"index", "inner", "insert", "interval", "into", "is", "join", "key", "left", "like",
+		"limit", "lock", "match", "natural", "not", "null", "on", "option", "or", "order",
+		"outer", "primary", "read", "references", "rename", "replace", "right", "select",
+		"set", "show", "table", "then", "to", "true", "union", "unique", "update", "usage",
+		"use", "using", "values", "when", "where", "with", "user",
+	},
+}
+
+// PostgresKeywords ...
+var PostgresKeywords = KeyWord{
+	words: []string{
+		"all", "analyse", "analyze", "and", "any", "array", "as", "asc", "asymmetric",
+		"both", "case", "cast", "check", "collate", "column", "constraint", "create",
+		"current_catalog", "current_date", "current_role", "current_time", "current_timestamp",
+		"current_user", "default", "deferrable", "desc", "distinct", "do", "else", "end",
+		"except", "false", "fetch", "for", "foreign", "from", "grant", "group", "having",
+		"in", "initially", "intersect", "into", "lateral", "leading", "limit", "localtime",
+		"localtimestamp", "not", "null", "offset", "on", "only", "or", "order", "placing",
+		"primary", "references", "returning", "select", "session_user", "some", "symmetric",
+		"table", "then", "to", "trailing", "true", "union", "unique", "user", "using",
+		"variadic", "when", "where", "window", "with",
+	},
+}
+
+// SQLServerKeywords ...
+var SQLServerKeywords = KeyWord{
+	words: []string{
+		"add", "all", "alter", "and", "any", "as", "asc", "authorization", "backup",
+		"begin", "between", "break", "browse", "bulk", "by", "cascade", "case", "check",
+		"checkpoint", "close", "clustered", "column", "commit", "compute", "constraint",
+		"contains", "containstable", "continue", "convert", "create", "cross", "current",
+		"current_date", "current_time", "current_timestamp", "current_user", "cursor",
+		"database", "dbcc", "deallocate", "declare", "default", "delete", "deny", "desc",
+		"disk", "distinct", "distributed", "double", "drop", "dump", "else", "end",
+		"errlvl", "escape", "except", "exec", "execute", "exists", "exit", "external",
+		"fetch", "file", "fillfactor", "for", "foreign", "freetext", "freetexttable",
+		"from", "full", "function", "goto", "grant", "group", "having", "holdlock",
+		"identity", "identity_insert", "identitycol", "if", "in", "index", "inner",
+		"insert", "intersect", "into", "is", "join", "key", "kill", "left", "like",
+		"lineno", "load", "merge", "national", "nocheck", "nonclustered", "not", "null",
+		"of", "off", "offsets", "on", "open", "opendatasource", "openquery", "openrowset",
+		"openxml", "option", "or", "order", "outer", "over", "percent", "pivot", "plan",
+		"precision", "primary", "print", "proc", "procedure", "public", "raiserror",
+		"read", "readtext", "reconfigure", "references", "replication", "restore",
+		"restrict", "return", "revert", "revoke", "right", "rollback", "rowcount",
+		"rowguidcol", "rule", "save", "schema", "securityaudit", "select", "semantickeyphrasetable",
+		"semanticsimilaritydetailstable", "semanticsimilaritytable", "session_user", "set",
+		"setuser", "shutdown", "some", "statistics", "system_user", "table", "tablesample",
+		"textsize", "then", "to", "top", "tran", "transaction", "trigger", "truncate",
+		"try_convert", "tsequal", "union", "unique", "unpivot", "update", "updatetext",
+		"use", "user", "values", "varying", "view", "waitfor", "when", "where", "while",
+		"with", "within group", "writetext",
+	},
+}
+
+// OracleKeywords ...
+var OracleKeywords = KeyWord{
+	words: []string{
+		"access", "account", "add", "all", "alter", "and", "any", "as", "asc", "audit",
+		"between", "by", "char", "check", "cluster", "column", "comment", "compress",
+		"connect", "create", "current", "date", "decimal", "default", "delete", "desc",
+		"distinct", "drop", "else", "exclusive", "exists", "file", "float", "for", "from",
+		"grant", "group", "having", "identified", "immediate", "in", "increment", "index",
+		"initial", "insert", "integer", "intersect", "into", "is", "level", "like", "lock",
+		"long", "maxextents", "minus", "mode", "modify", "noaudit", "nocompress", "not",
+		"notfound", "nowait", "null", "number", "of", "offline", "on", "online", "option",
+		"or", "order", "pctfree", "prior", "privileges", "public", "raw", "rename",
+		"resource", "revoke", "row", "rowid", "rownum", "rows", "select", "session",
+		"set", "share", "size", "smallint", "start", "successful", "synonym", "sysdate",
+		"table", "then", "to", "trigger", "uid", "union", "unique", "update", "user",
+		"validate", "values", "varchar", "varchar2", "view", "whenever", "where", "with",
+	},
+}
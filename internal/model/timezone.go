@@ -0,0 +1,15 @@
+package model
+
+import "gorm.io/gen/types"
+
+// timeGoType returns the Go type generated for a time-ish column,
+// honoring types.TimeLocation. hasTZ marks columns whose SQL type
+// carries its own time zone offset (e.g. Postgres timestamptz, SQL
+// Server datetimeoffset) as opposed to "timestamp without time
+// zone"-style columns, which always generate bare time.Time.
+func timeGoType(hasTZ bool) string {
+	if types.TimeLocation != nil && hasTZ {
+		return "types.LocalTime"
+	}
+	return "time.Time"
+}
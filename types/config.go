@@ -0,0 +1,24 @@
+package types
+
+import "time"
+
+// TimeLocation mirrors gen.Config's field of the same name: when set,
+// columns whose SQL type carries its own time zone offset (Postgres
+// timestamptz, SQL Server datetimeoffset) are generated as the
+// location-aware LocalTime wrapper instead of bare time.Time, and
+// LocalTime itself converts scanned values to this location on read.
+// Time columns without a zone (time, date, datetime, timestamp) are
+// generated as time.Time either way.
+var TimeLocation *time.Location
+
+// SetTimeLocation configures TimeLocation. Passing nil disables
+// location-aware generation and restores the bare time.Time mapping
+// for time-zone-carrying columns.
+//
+// This lives in the public types package, not internal/model, so that
+// applications importing generated models (which embed LocalTime) can
+// actually call it; internal/model reads this same variable when
+// deciding whether to generate LocalTime.
+func SetTimeLocation(appLocation *time.Location) {
+	TimeLocation = appLocation
+}
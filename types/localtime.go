@@ -0,0 +1,37 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// LocalTime wraps time.Time, converting it into TimeLocation (the
+// application's time zone) on Scan; Value returns the wrapped time
+// unchanged, without converting it to any database time zone. It implements sql.Scanner
+// and driver.Valuer, and is generated in place of time.Time for
+// time-zone-aware columns when gen.Config.TimeLocation is set.
+type LocalTime struct {
+	time.Time
+}
+
+// Scan implements sql.Scanner.
+func (t *LocalTime) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		t.Time = v
+	case nil:
+		t.Time = time.Time{}
+	default:
+		return fmt.Errorf("types: cannot scan %T into LocalTime", src)
+	}
+	if TimeLocation != nil {
+		t.Time = t.Time.In(TimeLocation)
+	}
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (t LocalTime) Value() (driver.Value, error) {
+	return t.Time, nil
+}
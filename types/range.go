@@ -0,0 +1,168 @@
+// Package types holds runtime helper types used by models generated
+// by gen, as opposed to internal/model which only deals with
+// generation-time metadata.
+package types
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// pgRangeTimeLayouts are the layouts Postgres uses to render a
+// time.Time range bound, tried in order. Bounds may additionally be
+// double-quoted (and backslash-escaped) by Postgres; unquoteRangeBound
+// strips that before a layout is tried.
+var pgRangeTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// unquoteRangeBound strips the double-quoting and backslash-escaping
+// Postgres applies to range bounds that contain special characters
+// (spaces, commas, brackets, quotes).
+func unquoteRangeBound(s string) string {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s
+	}
+	inner := s[1 : len(s)-1]
+	var buf strings.Builder
+	for i := 0; i < len(inner); i++ {
+		if inner[i] == '\\' && i+1 < len(inner) {
+			i++
+		}
+		buf.WriteByte(inner[i])
+	}
+	return buf.String()
+}
+
+// parseBound parses a single range bound into dst, which must be a
+// pointer to the range's element type. time.Time is handled
+// separately since it doesn't implement fmt.Scanner and so can't go
+// through fmt.Sscan.
+func parseBound(raw string, dst any) error {
+	text := unquoteRangeBound(raw)
+	if t, ok := dst.(*time.Time); ok {
+		var err error
+		for _, layout := range pgRangeTimeLayouts {
+			var parsed time.Time
+			if parsed, err = time.Parse(layout, text); err == nil {
+				*t = parsed
+				return nil
+			}
+		}
+		return fmt.Errorf("types: parsing range time bound %q: %w", raw, err)
+	}
+	if _, err := fmt.Sscan(text, dst); err != nil {
+		return fmt.Errorf("types: parsing range bound %q: %w", raw, err)
+	}
+	return nil
+}
+
+// writeBound renders a single range bound into buf, the inverse of
+// parseBound. time.Time is formatted with a layout Postgres accepts
+// as range input rather than Go's default time.Time string form.
+func writeBound(buf *bytes.Buffer, bound any) {
+	if t, ok := bound.(time.Time); ok {
+		fmt.Fprint(buf, t.Format("2006-01-02 15:04:05.999999999Z07:00"))
+		return
+	}
+	fmt.Fprint(buf, bound)
+}
+
+// Range represents a PostgreSQL range value such as int4range,
+// numrange or tstzrange, bounded by two values of type T. It
+// implements sql.Scanner and driver.Valuer so generated fields can
+// read and write range columns without manual marshaling.
+type Range[T any] struct {
+	Lower T
+	Upper T
+	// LowerSet and UpperSet report whether Lower/Upper hold a value;
+	// an unset bound represents an unbounded range end.
+	LowerSet bool
+	UpperSet bool
+	// LowerIncl and UpperIncl record whether the respective bound is
+	// inclusive ('[' / ']') rather than exclusive ('(' / ')').
+	LowerIncl bool
+	UpperIncl bool
+	// Empty marks the range as the literal "empty" range (no
+	// elements), as distinct from a zero Range, which Value renders
+	// as the unbounded range covering every element.
+	Empty bool
+}
+
+// Scan implements sql.Scanner, parsing PostgreSQL's range text
+// format, e.g. "[1,10)" or "empty".
+func (r *Range[T]) Scan(src any) error {
+	*r = Range[T]{}
+	if src == nil {
+		return nil
+	}
+	var text string
+	switch v := src.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("types: cannot scan %T into Range", src)
+	}
+	text = strings.TrimSpace(text)
+	if text == "" || text == "empty" {
+		r.Empty = true
+		return nil
+	}
+	if len(text) < 2 {
+		return fmt.Errorf("types: invalid range literal %q", text)
+	}
+	r.LowerIncl = text[0] == '['
+	r.UpperIncl = text[len(text)-1] == ']'
+	parts := strings.SplitN(text[1:len(text)-1], ",", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("types: invalid range literal %q", text)
+	}
+	if parts[0] != "" {
+		if err := parseBound(parts[0], &r.Lower); err != nil {
+			return err
+		}
+		r.LowerSet = true
+	}
+	if parts[1] != "" {
+		if err := parseBound(parts[1], &r.Upper); err != nil {
+			return err
+		}
+		r.UpperSet = true
+	}
+	return nil
+}
+
+// Value implements driver.Valuer, rendering r back into PostgreSQL's
+// range text format.
+func (r Range[T]) Value() (driver.Value, error) {
+	if r.Empty {
+		return "empty", nil
+	}
+	var buf bytes.Buffer
+	if r.LowerIncl {
+		buf.WriteByte('[')
+	} else {
+		buf.WriteByte('(')
+	}
+	if r.LowerSet {
+		writeBound(&buf, r.Lower)
+	}
+	buf.WriteByte(',')
+	if r.UpperSet {
+		writeBound(&buf, r.Upper)
+	}
+	if r.UpperIncl {
+		buf.WriteByte(']')
+	} else {
+		buf.WriteByte(')')
+	}
+	return buf.String(), nil
+}